@@ -3,8 +3,9 @@ package tree_sitter_faber_romanus_test
 import (
 	"testing"
 
-	tree_sitter "github.com/smacker/go-tree-sitter"
-	"github.com/tree-sitter/tree-sitter-faber_romanus"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	tree_sitter_faber_romanus "github.com/ianzepp/faber-romanus/bindings/go"
 )
 
 func TestCanLoadGrammar(t *testing.T) {
@@ -0,0 +1,95 @@
+// Package latinast provides a typed wrapper around the raw tree-sitter
+// parse tree produced by the faber-romanus grammar, so Go consumers can
+// work with Word, Sentence, Clause and QuotedPassage values instead of
+// hand-rolling NamedChild traversals over *tree_sitter.Node.
+package latinast
+
+import tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+// Node kinds produced by the faber-romanus grammar.
+const (
+	KindWord          = "word"
+	KindSentence      = "sentence"
+	KindClause        = "clause"
+	KindQuotedPassage = "quoted_passage"
+)
+
+// Word wraps a `word` node.
+type Word struct {
+	Node *tree_sitter.Node
+}
+
+// Sentence wraps a `sentence` node.
+type Sentence struct {
+	Node *tree_sitter.Node
+}
+
+// Clause wraps a `clause` node.
+type Clause struct {
+	Node *tree_sitter.Node
+}
+
+// QuotedPassage wraps a `quoted_passage` node.
+type QuotedPassage struct {
+	Node *tree_sitter.Node
+}
+
+// NodeText returns the source text spanned by node.
+func NodeText(node *tree_sitter.Node, source []byte) string {
+	return node.Utf8Text(source)
+}
+
+// WalkWords visits every `word` node in tree in document order, calling fn
+// for each one. Traversal stops early if fn returns false.
+func WalkWords(tree *tree_sitter.Tree, fn func(*Word) bool) {
+	walkKind(tree.RootNode(), KindWord, func(n *tree_sitter.Node) bool {
+		return fn(&Word{Node: n})
+	})
+}
+
+// Sentences returns every `sentence` node in tree, in document order.
+func Sentences(tree *tree_sitter.Tree) []*Sentence {
+	var sentences []*Sentence
+	walkKind(tree.RootNode(), KindSentence, func(n *tree_sitter.Node) bool {
+		sentences = append(sentences, &Sentence{Node: n})
+		return true
+	})
+	return sentences
+}
+
+// Clauses returns every `clause` node in tree, in document order.
+func Clauses(tree *tree_sitter.Tree) []*Clause {
+	var clauses []*Clause
+	walkKind(tree.RootNode(), KindClause, func(n *tree_sitter.Node) bool {
+		clauses = append(clauses, &Clause{Node: n})
+		return true
+	})
+	return clauses
+}
+
+// QuotedPassages returns every `quoted_passage` node in tree, in document order.
+func QuotedPassages(tree *tree_sitter.Tree) []*QuotedPassage {
+	var passages []*QuotedPassage
+	walkKind(tree.RootNode(), KindQuotedPassage, func(n *tree_sitter.Node) bool {
+		passages = append(passages, &QuotedPassage{Node: n})
+		return true
+	})
+	return passages
+}
+
+// walkKind performs a depth-first traversal of node's named descendants,
+// invoking fn for every descendant whose Kind matches kind. Traversal
+// stops early if fn returns false.
+func walkKind(node *tree_sitter.Node, kind string, fn func(*tree_sitter.Node) bool) bool {
+	if node.Kind() == kind {
+		if !fn(node) {
+			return false
+		}
+	}
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if !walkKind(node.NamedChild(i), kind, fn) {
+			return false
+		}
+	}
+	return true
+}
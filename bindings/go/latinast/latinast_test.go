@@ -0,0 +1,40 @@
+package latinast_test
+
+import (
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	tree_sitter_faber_romanus "github.com/ianzepp/faber-romanus/bindings/go"
+	"github.com/ianzepp/faber-romanus/bindings/go/latinast"
+)
+
+func TestWalkWordsAndSentences(t *testing.T) {
+	source := []byte("Gallia est omnis divisa in partes tres.")
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_faber_romanus.Language())); err != nil {
+		t.Fatalf("SetLanguage: %v", err)
+	}
+
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+
+	sentences := latinast.Sentences(tree)
+	if len(sentences) == 0 {
+		t.Fatalf("expected at least one sentence")
+	}
+
+	var words int
+	latinast.WalkWords(tree, func(w *latinast.Word) bool {
+		if latinast.NodeText(w.Node, source) == "" {
+			t.Errorf("word node had empty text")
+		}
+		words++
+		return true
+	})
+	if words == 0 {
+		t.Errorf("expected at least one word")
+	}
+}
@@ -0,0 +1,87 @@
+// Package morph runs the morphology.scm tree-sitter query over a
+// faber-romanus parse tree and turns its captures into a flat slice of
+// Token values, so Go consumers don't need to drive sitter.QueryCursor
+// and a capture-name switch themselves.
+package morph
+
+import (
+	_ "embed"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// This mirrors queries/morphology.scm at the repo root; it is embedded
+// here because go:embed cannot reach outside bindings/go/morph.
+//
+//go:embed queries/morphology.scm
+var morphologyQuery string
+
+// Token is one morphologically-tagged word extracted from a source
+// buffer.
+type Token struct {
+	Lemma    string
+	POS      string
+	Features []string
+}
+
+// Tokens runs the morphology query over tree and returns one Token per
+// captured word, using source to recover each capture's text.
+func Tokens(tree *tree_sitter.Tree, source []byte) ([]Token, error) {
+	query, err := tree_sitter.NewQuery(tree.Language(), morphologyQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer query.Close()
+
+	cursor := tree_sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	names := query.CaptureNames()
+	var tokens []Token
+	bySpan := make(map[[2]uint]int) // node byte range -> index into tokens
+
+	matches := cursor.Matches(query, tree.RootNode(), source)
+	for {
+		match := matches.Next()
+		if match == nil {
+			break
+		}
+		for _, capture := range match.Captures {
+			pos, feature := classify(names[capture.Index])
+			span := [2]uint{capture.Node.StartByte(), capture.Node.EndByte()}
+
+			if i, ok := bySpan[span]; ok {
+				tokens[i].Features = append(tokens[i].Features, feature)
+				if tokens[i].POS == "" {
+					tokens[i].POS = pos
+				}
+				continue
+			}
+
+			bySpan[span] = len(tokens)
+			tokens = append(tokens, Token{
+				Lemma:    capture.Node.Utf8Text(source),
+				POS:      pos,
+				Features: []string{feature},
+			})
+		}
+	}
+	return tokens, nil
+}
+
+// classify maps a morphology.scm capture name to a part of speech and a
+// feature tag.
+func classify(captureName string) (pos, feature string) {
+	switch captureName {
+	case "morphology.proper_noun":
+		return "PROPN", "proper"
+	case "morphology.enclitic":
+		return "PART", "enclitic"
+	case "morphology.declension":
+		return "NOUN", "declined"
+	case "morphology.conjugation":
+		return "VERB", "conjugated"
+	default:
+		return "", captureName
+	}
+}
@@ -0,0 +1,20 @@
+package morph
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		capture string
+		pos     string
+	}{
+		{"morphology.proper_noun", "PROPN"},
+		{"morphology.enclitic", "PART"},
+		{"morphology.declension", "NOUN"},
+		{"morphology.conjugation", "VERB"},
+	}
+	for _, c := range cases {
+		if pos, _ := classify(c.capture); pos != c.pos {
+			t.Errorf("classify(%q) = %q, want %q", c.capture, pos, c.pos)
+		}
+	}
+}
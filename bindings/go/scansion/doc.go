@@ -0,0 +1,4 @@
+// Package scansion annotates a faber-romanus parse tree with Latin
+// prosody: syllable boundaries, vowel quantity, elision, and the
+// classical meter (if any) that a line of verse scans as.
+package scansion
@@ -0,0 +1,234 @@
+package scansion
+
+// Foot is a contiguous span of syllables (by index into Scansion.Syllables)
+// forming one metrical foot.
+type Foot struct {
+	Start, End int // [Start, End) indices into Scansion.Syllables
+}
+
+// Scansion is the result of classifying a line of Latin text against the
+// classical meters.
+type Scansion struct {
+	Words     []string
+	Syllables []Syllable
+	Feet      []Foot
+	Meter     string
+	// Caesura is the syllable index of the main caesura, or -1 if the
+	// meter has none (e.g. hendecasyllabic).
+	Caesura int
+	Score   int
+}
+
+// footOption is one allowed realization of a foot, e.g. a dactyl or a
+// spondee. Common entries match either quantity.
+type footOption []Quantity
+
+type meterTemplate struct {
+	name    string
+	feet    [][]footOption
+	caesura int // foot index after which the main caesura falls, or -1
+}
+
+var dactyl = footOption{Long, Short, Short}
+var spondee = footOption{Long, Long}
+var trochee = footOption{Long, Short}
+
+var meterTemplates = []meterTemplate{
+	{
+		name: "dactylic hexameter",
+		feet: [][]footOption{
+			{dactyl, spondee},
+			{dactyl, spondee},
+			{dactyl, spondee},
+			{dactyl, spondee},
+			{dactyl, spondee},
+			{{Long, Common}},
+		},
+		caesura: 2,
+	},
+	{
+		name: "elegiac pentameter",
+		feet: [][]footOption{
+			{dactyl, spondee},
+			{dactyl, spondee},
+			{{Long}},
+			{dactyl},
+			{dactyl},
+			{{Common}},
+		},
+		caesura: 2,
+	},
+	{
+		name: "hendecasyllabic",
+		feet: [][]footOption{
+			{{Common, Common}},
+			{dactyl},
+			{trochee},
+			{trochee},
+			{{Long, Common}},
+		},
+		caesura: -1,
+	},
+	{
+		name: "iambic trimeter",
+		feet: [][]footOption{
+			{{Common, Long}},
+			{{Common, Long}},
+			{{Common, Long}},
+			{{Common, Long}},
+			{{Common, Long}},
+			{{Common, Long}},
+		},
+		caesura: -1,
+	},
+}
+
+// ClassifyMeter scans words into syllables and matches the resulting
+// quantity pattern against each classical meter template with a dynamic
+// program that chooses, foot by foot, the option (e.g. dactyl vs.
+// spondee) that best fits the observed quantities. It returns the
+// best-scoring meter.
+func ClassifyMeter(words []string) Scansion {
+	syllables := ScanWords(words)
+	quantities := make([]Quantity, 0, len(syllables))
+	index := make([]int, 0, len(syllables)) // maps quantities[i] -> syllables index
+	for i, s := range syllables {
+		if s.Elided {
+			continue
+		}
+		quantities = append(quantities, s.Quantity)
+		index = append(index, i)
+	}
+
+	best := Scansion{Words: words, Syllables: syllables, Meter: "unclassified", Caesura: -1}
+	for _, tmpl := range meterTemplates {
+		feet, score, ok := matchTemplate(tmpl, quantities)
+		if !ok || !meetsAcceptanceThreshold(score, len(quantities)) {
+			// A template being reachable by syllable count alone proves
+			// nothing: without this, any line whose syllable count fell
+			// in a template's range was reported as that meter even when
+			// none of its long/short values actually matched. Require
+			// most of the line's quantities to agree with the template
+			// before accepting it (quantity detection from unmacronized
+			// text is inherently lossy, so we allow a margin rather than
+			// requiring a perfect score).
+			continue
+		}
+		if best.Meter == "unclassified" || score > best.Score {
+			best.Meter = tmpl.name
+			best.Score = score
+			best.Feet = remapFeet(feet, index)
+			best.Caesura = caesuraIndex(tmpl, feet, index)
+		}
+	}
+	return best
+}
+
+// matchTemplate runs a DP over feet × syllable-position, choosing for
+// each foot the option whose length best accounts for the remaining
+// syllables, and returns the feet boundaries (in quantities-slice
+// indices) and the total number of syllables whose quantity matched the
+// chosen pattern exactly.
+func matchTemplate(tmpl meterTemplate, quantities []Quantity) ([]Foot, int, bool) {
+	n := len(quantities)
+	numFeet := len(tmpl.feet)
+
+	const unreachable = -1
+	dp := make([][]int, numFeet+1)
+	choice := make([][]struct{ option, prevPos int }, numFeet+1)
+	for i := range dp {
+		dp[i] = make([]int, n+1)
+		choice[i] = make([]struct{ option, prevPos int }, n+1)
+		for j := range dp[i] {
+			dp[i][j] = unreachable
+		}
+	}
+	dp[0][0] = 0
+
+	for foot := 0; foot < numFeet; foot++ {
+		for pos := 0; pos <= n; pos++ {
+			if dp[foot][pos] == unreachable {
+				continue
+			}
+			for oi, option := range tmpl.feet[foot] {
+				end := pos + len(option)
+				if end > n {
+					continue
+				}
+				score := dp[foot][pos] + matchScore(option, quantities[pos:end])
+				if dp[foot+1][end] == unreachable || score > dp[foot+1][end] {
+					dp[foot+1][end] = score
+					choice[foot+1][end] = struct{ option, prevPos int }{oi, pos}
+				}
+			}
+		}
+	}
+
+	if dp[numFeet][n] == unreachable {
+		return nil, 0, false
+	}
+
+	feet := make([]Foot, numFeet)
+	pos := n
+	for foot := numFeet; foot > 0; foot-- {
+		c := choice[foot][pos]
+		feet[foot-1] = Foot{Start: c.prevPos, End: pos}
+		pos = c.prevPos
+	}
+	return feet, dp[numFeet][n], true
+}
+
+// acceptanceThreshold is the minimum fraction of a template's slots that
+// must match the observed quantities before ClassifyMeter will report
+// that meter, rather than "unclassified".
+const acceptanceThreshold = 0.85
+
+func meetsAcceptanceThreshold(score, total int) bool {
+	if total == 0 {
+		return false
+	}
+	return float64(score) >= acceptanceThreshold*float64(total)
+}
+
+func matchScore(option footOption, actual []Quantity) int {
+	score := 0
+	for i, q := range option {
+		// A Common actual quantity (e.g. a muta-cum-liquida syllable, or
+		// the anceps final syllable of the line) is ambiguous by
+		// definition, so it's compatible with whatever the template
+		// requires there.
+		if q == Common || actual[i] == Common || q == actual[i] {
+			score++
+		}
+	}
+	return score
+}
+
+// remapFeet converts Foot boundaries expressed in quantities-slice
+// indices back to indices into the full (elision-inclusive) syllables
+// slice.
+func remapFeet(feet []Foot, index []int) []Foot {
+	out := make([]Foot, len(feet))
+	for i, f := range feet {
+		start := index[f.Start]
+		var end int
+		if f.End < len(index) {
+			end = index[f.End]
+		} else {
+			end = index[len(index)-1] + 1
+		}
+		out[i] = Foot{Start: start, End: end}
+	}
+	return out
+}
+
+func caesuraIndex(tmpl meterTemplate, feet []Foot, index []int) int {
+	if tmpl.caesura < 0 || tmpl.caesura >= len(feet) {
+		return -1
+	}
+	pos := feet[tmpl.caesura].End
+	if pos >= len(index) {
+		return -1
+	}
+	return index[pos]
+}
@@ -0,0 +1,46 @@
+package scansion
+
+import "testing"
+
+func TestClassifyMeterDactylicHexameter(t *testing.T) {
+	// Vergil, Aeneid 1.1.
+	words := []string{"arma", "virumque", "cano", "Troiae", "qui", "primus", "ab", "oris"}
+
+	s := ClassifyMeter(words)
+
+	if s.Meter != "dactylic hexameter" {
+		t.Fatalf("Meter = %q, want %q", s.Meter, "dactylic hexameter")
+	}
+	if len(s.Feet) != 6 {
+		t.Fatalf("len(Feet) = %d, want 6", len(s.Feet))
+	}
+}
+
+func TestClassifyMeterRejectsNonMetricalProse(t *testing.T) {
+	// Sixteen uniformly short syllables: the same syllable count as a
+	// dactylic hexameter line, but none of the long/short values match
+	// any foot in any template, so this must not be classified.
+	words := []string{"ego", "tibi", "modo", "puto", "bene", "mihi", "cave", "tibi"}
+
+	s := ClassifyMeter(words)
+
+	if s.Meter != "unclassified" {
+		t.Fatalf("Meter = %q, want %q (score %d)", s.Meter, "unclassified", s.Score)
+	}
+}
+
+func TestScanWordsElision(t *testing.T) {
+	// "multum ille" elides the final -um of "multum" before the
+	// following word's initial vowel.
+	syllables := ScanWords([]string{"multum", "ille"})
+
+	var elided int
+	for _, s := range syllables {
+		if s.Elided {
+			elided++
+		}
+	}
+	if elided != 1 {
+		t.Fatalf("elided syllable count = %d, want 1", elided)
+	}
+}
@@ -0,0 +1,163 @@
+package scansion
+
+import "strings"
+
+// Quantity is the duration of a syllable nucleus.
+type Quantity int
+
+const (
+	// Short syllables count as one mora ("breve").
+	Short Quantity = iota
+	// Long syllables count as two morae ("longum").
+	Long
+	// Common syllables may scan as either, used for the anceps final
+	// syllable of a line.
+	Common
+)
+
+func (q Quantity) String() string {
+	switch q {
+	case Long:
+		return "long"
+	case Common:
+		return "common"
+	default:
+		return "short"
+	}
+}
+
+// Syllable is a single syllable of a scanned line, with its owning word
+// index so callers can map back to the source words.
+type Syllable struct {
+	Text     string
+	Word     int
+	Quantity Quantity
+	Elided   bool
+}
+
+const macronVowels = "āēīōūȳĀĒĪŌŪȲ"
+
+func isMacron(r rune) bool {
+	return strings.ContainsRune(macronVowels, r)
+}
+
+func nucleusOf(syllable string) (text string, startsAt int) {
+	runes := []rune(syllable)
+	for i, r := range runes {
+		if isVowel(r) && !isConsonantalU(runes, i) {
+			if i+1 < len(runes) && isVowel(runes[i+1]) && isDiphthong(runes[i], runes[i+1]) {
+				return string(runes[i : i+2]), i
+			}
+			return string(r), i
+		}
+	}
+	return "", -1
+}
+
+func endsInVowelOrM(word string) bool {
+	runes := []rune(word)
+	if len(runes) == 0 {
+		return false
+	}
+	last := lower(runes[len(runes)-1])
+	if last == 'm' && len(runes) > 1 {
+		return isVowel(runes[len(runes)-2])
+	}
+	return isVowel(last)
+}
+
+func startsWithVowelOrH(word string) bool {
+	runes := []rune(strings.TrimSpace(word))
+	if len(runes) == 0 {
+		return false
+	}
+	first := lower(runes[0])
+	if first == 'h' && len(runes) > 1 {
+		return isVowel(lower(runes[1]))
+	}
+	return isVowel(first)
+}
+
+// ScanWords syllabifies every word in a line, assigns each syllable's
+// quantity by nature or by position, and marks elisions where a word
+// ending in a vowel (or vowel+m) is followed by a word starting with a
+// vowel or h.
+func ScanWords(words []string) []Syllable {
+	perWord := make([][]string, len(words))
+	for i, w := range words {
+		perWord[i] = Syllabify(w)
+	}
+
+	var out []Syllable
+	for wi, syllables := range perWord {
+		for si, syl := range syllables {
+			nucleus, _ := nucleusOf(syl)
+
+			// The text following this syllable's nucleus within the line,
+			// used to test "long by position".
+			var following string
+			if si+1 < len(syllables) {
+				following = strings.Join(syllables[si+1:], "")
+			} else if wi+1 < len(words) {
+				following = words[wi+1]
+			}
+			coda := syl[strings.Index(syl, nucleus)+len(nucleus):]
+
+			s := Syllable{Text: syl, Word: wi, Quantity: quantityOf(nucleus, coda, following)}
+
+			isLastSyllableOfWord := si == len(syllables)-1
+			isLastWord := wi == len(words)-1
+			if isLastSyllableOfWord && !isLastWord && endsInVowelOrM(words[wi]) && startsWithVowelOrH(words[wi+1]) {
+				s.Elided = true
+			}
+			if isLastSyllableOfWord && isLastWord {
+				s.Quantity = Common
+			}
+
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// quantityOf determines the quantity of a syllable from its vowel nucleus,
+// the consonants remaining in its own coda, and the text that follows it
+// (the rest of the word, or the next word across a line boundary).
+func quantityOf(nucleus, coda, following string) Quantity {
+	if nucleus == "" {
+		return Short
+	}
+	runes := []rune(nucleus)
+	if len(runes) > 1 {
+		return Long // diphthong
+	}
+	if isMacron(runes[0]) {
+		return Long
+	}
+
+	consonants := coda
+	for _, r := range following {
+		if isVowel(r) {
+			break
+		}
+		consonants += string(r)
+	}
+	consonants = strings.TrimSpace(consonants)
+
+	if len(consonants) == 0 {
+		return Short
+	}
+	if strings.ContainsAny(consonants[:1], "xXzZ") {
+		return Long
+	}
+	if consonantRunes := []rune(consonants); len(consonantRunes) >= 2 {
+		if len(consonantRunes) == 2 && isMutaCumLiquida(strings.ToLower(consonants)) {
+			// muta cum liquida (e.g. the "tr" of "pa-tris"): the syllable
+			// is common, scanned long or short at the poet's discretion,
+			// not forced long.
+			return Common
+		}
+		return Long
+	}
+	return Short
+}
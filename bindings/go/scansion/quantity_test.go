@@ -0,0 +1,18 @@
+package scansion
+
+import "testing"
+
+func TestQuantityOfMutaCumLiquidaIsCommonNotLong(t *testing.T) {
+	// "pa-tris": the short "a" is followed by "tr", a muta-cum-liquida
+	// cluster, so it must scan as common rather than being forced long
+	// purely because two consonant letters happen to follow it.
+	syllables := Syllabify("patris")
+	if len(syllables) != 2 || syllables[0] != "pa" {
+		t.Fatalf("Syllabify(%q) = %v, want [pa tris]", "patris", syllables)
+	}
+
+	got := ScanWords([]string{"patris", "meus"})[0].Quantity
+	if got != Common {
+		t.Errorf("quantity of %q = %v, want %v", syllables[0], got, Common)
+	}
+}
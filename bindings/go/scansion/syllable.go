@@ -0,0 +1,135 @@
+package scansion
+
+import "strings"
+
+// vowels (including macronized forms) recognised as syllable nuclei.
+const vowelLetters = "aeiouyAEIOUYāēīōūȳĀĒĪŌŪȲ"
+
+// diphthongs that form a single syllable nucleus.
+var diphthongs = []string{"ae", "au", "oe", "ei", "eu", "ui"}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowelLetters, r)
+}
+
+// isConsonantalU reports whether the u at index i of runes is consonantal,
+// i.e. part of qu/gu (+ vowel) and therefore not a syllable nucleus.
+func isConsonantalU(runes []rune, i int) bool {
+	if i == 0 {
+		return false
+	}
+	prev := lower(runes[i-1])
+	if prev != 'q' && prev != 'g' {
+		return false
+	}
+	return i+1 < len(runes) && isVowel(runes[i+1])
+}
+
+func lower(r rune) rune {
+	if r >= 'A' && r <= 'Z' {
+		return r + ('a' - 'A')
+	}
+	return r
+}
+
+// Syllabify splits a single Latin word into its syllables, following the
+// standard rules: one vowel or diphthong nucleus per syllable, a single
+// intervocalic consonant goes with the following syllable, consonant
+// clusters split between syllables except for "muta cum liquida" (a stop
+// followed by l/r), and qu/gu before a vowel count as a single consonant.
+func Syllabify(word string) []string {
+	runes := []rune(word)
+	nuclei := nucleusSpans(runes)
+	if len(nuclei) == 0 {
+		return []string{word}
+	}
+
+	syllables := make([]string, 0, len(nuclei))
+	start := 0
+	for i, nucleus := range nuclei {
+		var end int
+		if i == len(nuclei)-1 {
+			end = len(runes)
+		} else {
+			end = splitPoint(runes, nucleus.end, nuclei[i+1].start)
+		}
+		syllables = append(syllables, string(runes[start:end]))
+		start = end
+	}
+	return syllables
+}
+
+type span struct{ start, end int }
+
+// nucleusSpans finds the run of vowel letters that forms each syllable
+// nucleus, merging diphthongs into a single span and treating consonantal
+// u (qu/gu + vowel) as a consonant rather than a nucleus.
+func nucleusSpans(runes []rune) []span {
+	var spans []span
+	for i := 0; i < len(runes); i++ {
+		if !isVowel(runes[i]) || isConsonantalU(runes, i) {
+			continue
+		}
+		if i+1 < len(runes) && isVowel(runes[i+1]) && !isConsonantalU(runes, i+1) && isDiphthong(runes[i], runes[i+1]) {
+			spans = append(spans, span{i, i + 2})
+			i++
+			continue
+		}
+		spans = append(spans, span{i, i + 1})
+	}
+	return spans
+}
+
+func isDiphthong(a, b rune) bool {
+	pair := string(lower(a)) + string(lower(b))
+	for _, d := range diphthongs {
+		if d == pair {
+			return true
+		}
+	}
+	return false
+}
+
+// splitPoint decides where to break the consonants between two nuclei
+// (ending at prevEnd, starting at nextStart) into the coda of the
+// preceding syllable and the onset of the following one.
+func splitPoint(runes []rune, prevEnd, nextStart int) int {
+	consonants := runes[prevEnd:nextStart]
+	switch len(consonants) {
+	case 0:
+		return prevEnd
+	case 1:
+		return prevEnd
+	default:
+		// A digraph (ch, ph, th, rh) or "muta cum liquida" pair counts as a
+		// single onset consonant and stays with the following syllable.
+		last2 := string(lower(consonants[len(consonants)-2])) + string(lower(consonants[len(consonants)-1]))
+		if isDigraph(last2) || isMutaCumLiquida(last2) || isQuGu(last2) {
+			return nextStart - 2
+		}
+		return nextStart - 1
+	}
+}
+
+func isDigraph(pair string) bool {
+	switch pair {
+	case "ch", "ph", "th", "rh":
+		return true
+	}
+	return false
+}
+
+// isQuGu reports whether pair is "qu" or "gu" acting as a single
+// consonant before a vowel (e.g. the "qu" of "virumque").
+func isQuGu(pair string) bool {
+	return pair == "qu" || pair == "gu"
+}
+
+func isMutaCumLiquida(pair string) bool {
+	if len(pair) != 2 {
+		return false
+	}
+	muta := strings.ContainsRune("bcdgptk", rune(pair[0]))
+	liquida := pair[1] == 'l' || pair[1] == 'r'
+	return muta && liquida
+}
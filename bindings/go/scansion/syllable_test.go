@@ -0,0 +1,25 @@
+package scansion
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSyllabify(t *testing.T) {
+	cases := []struct {
+		word string
+		want []string
+	}{
+		{"arma", []string{"ar", "ma"}},
+		{"virumque", []string{"vi", "rum", "que"}},
+		{"Troiae", []string{"Tro", "i", "ae"}},
+		{"patris", []string{"pa", "tris"}},
+		{"philosophia", []string{"phi", "lo", "so", "phi", "a"}},
+	}
+	for _, c := range cases {
+		got := Syllabify(c.word)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Syllabify(%q) = %v, want %v", c.word, got, c.want)
+		}
+	}
+}
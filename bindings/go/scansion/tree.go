@@ -0,0 +1,29 @@
+package scansion
+
+import (
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/ianzepp/faber-romanus/bindings/go/latinast"
+)
+
+// ScanTree classifies every sentence in tree against the classical
+// meters, using source to recover each word's text.
+func ScanTree(tree *tree_sitter.Tree, source []byte) []Scansion {
+	var scansions []Scansion
+	for _, sentence := range latinast.Sentences(tree) {
+		var words []string
+		latinast.WalkWords(tree, func(w *latinast.Word) bool {
+			if !contains(sentence.Node, w.Node) {
+				return true
+			}
+			words = append(words, latinast.NodeText(w.Node, source))
+			return true
+		})
+		scansions = append(scansions, ClassifyMeter(words))
+	}
+	return scansions
+}
+
+func contains(outer, inner *tree_sitter.Node) bool {
+	return outer.StartByte() <= inner.StartByte() && inner.EndByte() <= outer.EndByte()
+}
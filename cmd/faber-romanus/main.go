@@ -0,0 +1,40 @@
+// Command faber-romanus provides CLI utilities on top of the
+// faber-romanus grammar, such as prosodic scansion of Latin verse.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "scan":
+		err = runScan(os.Args[2:])
+	case "help", "-h", "--help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "faber-romanus: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "faber-romanus: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: faber-romanus <command> [arguments]
+
+commands:
+  scan   annotate a line of Latin verse with syllable quantities and classify its meter`)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/ianzepp/faber-romanus/bindings/go/scansion"
+)
+
+// runScan implements `faber-romanus scan`. It reads one line of Latin
+// verse per line of input (a file argument, or stdin), and prints the
+// per-syllable quantities, elisions, foot boundaries and detected meter
+// for each.
+func runScan(args []string) error {
+	fs := flag.NewFlagSet("scan", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: faber-romanus scan [file]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if fs.NArg() > 0 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	lines, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		words := wordsOf(line)
+		if len(words) == 0 {
+			continue
+		}
+		printScansion(scansion.ClassifyMeter(words))
+	}
+	return nil
+}
+
+// wordsOf splits a line of verse into words, stripping the sentence and
+// clause punctuation (periods, commas, quotes, etc.) that real Latin
+// text carries but ScanWords has no business seeing.
+func wordsOf(line string) []string {
+	var words []string
+	for _, field := range strings.Fields(line) {
+		word := strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func readLines(r io.Reader) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+func printScansion(s scansion.Scansion) {
+	var scanned int
+	for _, syl := range s.Syllables {
+		if !syl.Elided {
+			scanned++
+		}
+	}
+	fmt.Printf("%s [%s, score %d/%d]\n", strings.Join(s.Words, " "), s.Meter, s.Score, scanned)
+	for _, syl := range s.Syllables {
+		mark := "-"
+		switch syl.Quantity {
+		case scansion.Long:
+			mark = "–"
+		case scansion.Common:
+			mark = "x"
+		}
+		if syl.Elided {
+			fmt.Printf("  (%s) elided\n", syl.Text)
+			continue
+		}
+		fmt.Printf("  %s %s\n", syl.Text, mark)
+	}
+	for i, f := range s.Feet {
+		fmt.Printf("  foot %d: syllables [%d,%d)\n", i+1, f.Start, f.End)
+	}
+	if s.Caesura >= 0 {
+		fmt.Printf("  caesura after syllable %d\n", s.Caesura)
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWordsOfStripsPunctuation(t *testing.T) {
+	got := wordsOf(`Arma virumque cano, Troiae qui primus ab oris.`)
+	want := []string{"Arma", "virumque", "cano", "Troiae", "qui", "primus", "ab", "oris"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("wordsOf(...) = %v, want %v", got, want)
+	}
+}
+
+func TestReadLinesSkipsBlank(t *testing.T) {
+	lines, err := readLines(strings.NewReader("arma virumque cano\n\n  \nTroiae qui primus\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("len(lines) = %d, want 2", len(lines))
+	}
+}